@@ -0,0 +1,114 @@
+package chartjs
+
+import "fmt"
+
+// Annotation wraps a single entry of the chartjs-plugin-annotation
+// "annotations" map. Not all fields apply to every Type; see the plugin's
+// docs for which ones a given Type consumes.
+type Annotation struct {
+	Type string `json:"type"`
+
+	// Line annotations.
+	Mode    string   `json:"mode,omitempty"`
+	ScaleID string   `json:"scaleID,omitempty"`
+	Value   *float64 `json:"value,omitempty"`
+
+	// Box annotations.
+	XMin *float64 `json:"xMin,omitempty"`
+	XMax *float64 `json:"xMax,omitempty"`
+	YMin *float64 `json:"yMin,omitempty"`
+	YMax *float64 `json:"yMax,omitempty"`
+
+	// Point annotations.
+	XValue *float64 `json:"xValue,omitempty"`
+	YValue *float64 `json:"yValue,omitempty"`
+
+	BorderColor     *RGBA            `json:"borderColor,omitempty"`
+	BackgroundColor *RGBA            `json:"backgroundColor,omitempty"`
+	BorderWidth     float64          `json:"borderWidth,omitempty"`
+	Label           *AnnotationLabel `json:"label,omitempty"`
+}
+
+// AnnotationLabel configures the text chartjs-plugin-annotation draws on
+// (or next to) an Annotation.
+type AnnotationLabel struct {
+	Enabled Bool   `json:"enabled,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+// AnnotationPlugin wraps the chartjs-plugin-annotation "annotation" plugin
+// block, keyed by an arbitrary annotation ID as the plugin requires.
+type AnnotationPlugin struct {
+	Annotations map[string]Annotation `json:"annotations,omitempty"`
+}
+
+// Plugins wraps the chartjs "plugins" options block.
+type Plugins struct {
+	Annotation *AnnotationPlugin `json:"annotation,omitempty"`
+}
+
+func (c *Chart) annotationPlugin() *AnnotationPlugin {
+	if c.Options.Plugins == nil {
+		c.Options.Plugins = &Plugins{}
+	}
+	if c.Options.Plugins.Annotation == nil {
+		c.Options.Plugins.Annotation = &AnnotationPlugin{Annotations: map[string]Annotation{}}
+	}
+	return c.Options.Plugins.Annotation
+}
+
+func (c *Chart) addAnnotation(prefix string, a Annotation) {
+	p := c.annotationPlugin()
+	n := 1
+	for {
+		id := fmt.Sprintf("%s%d", prefix, n)
+		if _, exists := p.Annotations[id]; !exists {
+			p.Annotations[id] = a
+			return
+		}
+		n++
+	}
+}
+
+// AddMarkLine adds a horizontal threshold line at y=v, labeled label.
+func (c *Chart) AddMarkLine(v float64, label string, color RGBA) {
+	c.addAnnotation("line", Annotation{
+		Type:        "line",
+		Mode:        "horizontal",
+		ScaleID:     "y-axis-0",
+		Value:       &v,
+		BorderColor: &color,
+		Label:       &AnnotationLabel{Enabled: True, Content: label},
+	})
+}
+
+// AddMarkPoint highlights the single point (x, y), labeled label.
+func (c *Chart) AddMarkPoint(x, y float64, label string, color RGBA) {
+	c.addAnnotation("point", Annotation{
+		Type:            "point",
+		XValue:          &x,
+		YValue:          &y,
+		BackgroundColor: &color,
+		Label:           &AnnotationLabel{Enabled: True, Content: label},
+	})
+}
+
+// AddBox highlights the region [xMin,xMax] x [yMin,yMax], labeled label.
+func (c *Chart) AddBox(xMin, xMax, yMin, yMax float64, label string, color RGBA) {
+	c.addAnnotation("box", Annotation{
+		Type:            "box",
+		XMin:            &xMin,
+		XMax:            &xMax,
+		YMin:            &yMin,
+		YMax:            &yMax,
+		BackgroundColor: &color,
+		Label:           &AnnotationLabel{Enabled: True, Content: label},
+	})
+}
+
+// hasAnnotations reports whether the chart carries any annotation plugin
+// config, so RenderHTML knows to load the plugin's script.
+func (c *Chart) hasAnnotations() bool {
+	return c.Options.Plugins != nil && c.Options.Plugins.Annotation != nil &&
+		len(c.Options.Plugins.Annotation.Annotations) > 0
+}
@@ -0,0 +1,88 @@
+package chartjs
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestChartAddMarkLinePointBox(t *testing.T) {
+	c := &Chart{Type: Line}
+	c.AddMarkLine(42, "target", RGBA{R: 255, A: 255})
+	c.AddMarkPoint(1, 2, "peak", RGBA{G: 255, A: 255})
+	c.AddBox(0, 1, 0, 1, "region", RGBA{B: 255, A: 128})
+
+	if !c.hasAnnotations() {
+		t.Fatalf("hasAnnotations() = false, want true after adding annotations")
+	}
+
+	buf, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var out struct {
+		Options struct {
+			Plugins struct {
+				Annotation struct {
+					Annotations map[string]struct {
+						Type  string `json:"type"`
+						Label struct {
+							Content string `json:"content"`
+						} `json:"label"`
+					} `json:"annotations"`
+				} `json:"annotation"`
+			} `json:"plugins"`
+		} `json:"options"`
+	}
+	if err := json.Unmarshal(buf, &out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	annotations := out.Options.Plugins.Annotation.Annotations
+	cases := map[string]struct {
+		wantType  string
+		wantLabel string
+	}{
+		"line1":  {"line", "target"},
+		"point1": {"point", "peak"},
+		"box1":   {"box", "region"},
+	}
+	for id, want := range cases {
+		got, ok := annotations[id]
+		if !ok {
+			t.Errorf("missing annotation %q in %v", id, annotations)
+			continue
+		}
+		if got.Type != want.wantType {
+			t.Errorf("annotation %q type = %q, want %q", id, got.Type, want.wantType)
+		}
+		if got.Label.Content != want.wantLabel {
+			t.Errorf("annotation %q label = %q, want %q", id, got.Label.Content, want.wantLabel)
+		}
+	}
+}
+
+func TestChartWithoutAnnotationsOmitsPluginsBlock(t *testing.T) {
+	c := &Chart{Type: Line}
+
+	if c.hasAnnotations() {
+		t.Fatalf("hasAnnotations() = true, want false with no annotations added")
+	}
+
+	buf, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(buf, &out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	options, ok := out["options"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("got options = %#v, want an object", out["options"])
+	}
+	if _, ok := options["plugins"]; ok {
+		t.Errorf("got options.plugins = %v, want it omitted with no annotations", options["plugins"])
+	}
+}
@@ -20,6 +20,9 @@ var chartTypes = [...]string{
 	"line",
 	"bar",
 	"bubble",
+	"sunburst",
+	"polarArea",
+	"radar",
 }
 
 type chartType int
@@ -35,6 +38,15 @@ const (
 	Bar
 	// Bubble is a "bubble" plot
 	Bubble
+	// Sunburst is a "sunburst" hierarchical plot, rendered via the
+	// chartjs-chart-sunburst plugin.
+	Sunburst
+	// PolarArea is a "polarArea" plot, plotted against the single
+	// radial Scale rather than Scales.XAxes/YAxes.
+	PolarArea
+	// Radar is a "radar" plot, plotted against the single radial Scale
+	// rather than Scales.XAxes/YAxes.
+	Radar
 )
 
 // FloatFormat determines how many decimal places are sent in the JSON.
@@ -50,7 +62,20 @@ type Values interface {
 	Rs() []float64
 }
 
+// MarshalValues serializes v into the same chart.js point-array JSON that
+// Dataset.MarshalJSON uses for its "data" field. It exists so packages
+// outside chartjs (e.g. chartjs/live, which streams incremental Values to
+// already-rendered charts) can produce a wire-compatible payload without
+// reimplementing this format.
+func MarshalValues(v Values) ([]byte, error) {
+	return marshalValuesJSON(v)
+}
+
 func marshalValuesJSON(v Values) ([]byte, error) {
+	if rv, ok := v.(RichValues); ok {
+		return marshalRichValuesJSON(rv)
+	}
+
 	xs, ys, rs := v.Xs(), v.Ys(), v.Rs()
 	if len(xs) == 0 {
 		if len(rs) != 0 {
@@ -143,8 +168,38 @@ func (d Dataset) MarshalJSON() ([]byte, error) {
 
 // Data wraps the "data" JSON
 type Data struct {
-	Datasets []Dataset `json:"datasets"`
-	Labels   []string  `json:"labels"`
+	Datasets []Dataset `json:"-"`
+	// Trees holds hierarchical datasets (e.g. for Sunburst charts). It is
+	// mutually exclusive with Datasets: a chart is either flat or nested.
+	Trees  []TreeDataset `json:"-"`
+	Labels []string      `json:"labels"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (d Data) MarshalJSON() ([]byte, error) {
+	type alias Data
+	buf, err := json.Marshal(alias(d))
+	if err != nil {
+		return nil, err
+	}
+	o, err := json.Marshal(d.Datasets)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.Trees) > 0 {
+		o, err = json.Marshal(d.Trees)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// replace '}' with ',' to continue struct
+	if len(buf) > 0 {
+		buf[len(buf)-1] = ','
+	}
+	buf = append(buf, []byte(`"datasets":`)...)
+	buf = append(buf, o...)
+	buf = append(buf, '}')
+	return buf, nil
 }
 
 type axisType int
@@ -249,6 +304,28 @@ type Option struct {
 type Options struct {
 	Option
 	Scales Axes `json:"scales,omitempty"`
+	// Scale holds the single radial scale used by polar/radar charts, set
+	// via Chart.SetPolarAxis. Chart.js requires such charts to carry a
+	// singular "scale", not "scales.xAxes/yAxes", so when Scale is set it
+	// is serialized in place of Scales.
+	Scale    *Axis            `json:"-"`
+	Sunburst *SunburstOptions `json:"sunburst,omitempty"`
+	Plugins  *Plugins         `json:"plugins,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (o Options) MarshalJSON() ([]byte, error) {
+	if o.Scale == nil {
+		type alias Options
+		return json.Marshal(alias(o))
+	}
+	type polarAlias struct {
+		Option
+		Scale    *Axis            `json:"scale,omitempty"`
+		Sunburst *SunburstOptions `json:"sunburst,omitempty"`
+		Plugins  *Plugins         `json:"plugins,omitempty"`
+	}
+	return json.Marshal(polarAlias{Option: o.Option, Scale: o.Scale, Sunburst: o.Sunburst, Plugins: o.Plugins})
 }
 
 type Chart struct {
@@ -258,11 +335,43 @@ type Chart struct {
 	Options Options   `json:"options,omitempty"`
 }
 
-// AddDataset adds a dataset to the chart.
+// AddDataset adds a dataset to the chart. If d.Data is a RichValues with a
+// non-empty Times(), the chart's X-axes are switched to Time so chart.js
+// parses the ISO-8601 points that will be emitted for this dataset.
 func (c *Chart) AddDataset(d Dataset) {
+	if rv, ok := d.Data.(RichValues); ok && len(rv.Times()) > 0 {
+		c.useTimeXAxis()
+	}
 	c.Data.Datasets = append(c.Data.Datasets, d)
 }
 
+func (c *Chart) useTimeXAxis() {
+	if len(c.Options.Scales.XAxes) == 0 {
+		c.AddXAxis(Axis{Type: Time})
+		return
+	}
+	for i := range c.Options.Scales.XAxes {
+		c.Options.Scales.XAxes[i].Type = Time
+	}
+}
+
+// AddTreeDataset adds a hierarchical dataset to the chart, for use with
+// chart types like Sunburst.
+func (c *Chart) AddTreeDataset(d TreeDataset) {
+	c.Data.Trees = append(c.Data.Trees, d)
+}
+
+// SetSunburstOptions attaches Sunburst/Tree plugin options to the chart.
+func (c *Chart) SetSunburstOptions(o SunburstOptions) {
+	c.Options.Sunburst = &o
+}
+
+// SetPolarAxis sets the single radial scale used by PolarArea and Radar
+// charts. Use this instead of AddXAxis/AddYAxis for those chart types.
+func (c *Chart) SetPolarAxis(a Axis) {
+	c.Options.Scale = &a
+}
+
 // AddXAxis adds an x-axis to the chart.
 func (c *Chart) AddXAxis(x Axis) {
 	c.Options.Scales.XAxes = append(c.Options.Scales.XAxes, x)
@@ -0,0 +1,178 @@
+// Package hist turns raw samples into a chartjs.Dataset, bucketing values
+// into bins the way a plotting library's histogram would, without requiring
+// callers to do the binning themselves.
+package hist
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	chartjs "github.com/viseth/go-chartjs"
+)
+
+// BinRule picks the number of bins from the sample count/spread when
+// HistOpts.Bins is left at zero.
+type BinRule int
+
+const (
+	// Sturges is a good default for small, roughly normal samples.
+	Sturges BinRule = iota
+	// Rice scales with the cube root of the sample count.
+	Rice
+	// Sqrt uses the square root of the sample count.
+	Sqrt
+	// FreedmanDiaconis adapts bin width to the sample's interquartile
+	// range, so it tolerates outliers better than the others.
+	FreedmanDiaconis
+)
+
+// HistOpts configures NewHistogram.
+type HistOpts struct {
+	// Bins is the number of bins. If zero, it is derived from Rule.
+	Bins int
+	// Rule picks Bins automatically when Bins is zero.
+	Rule BinRule
+	// Min and Max bound the histogram range. If both are zero, they are
+	// taken from the sample data.
+	Min, Max float64
+	// Normalize reports bin density (area under all bars sums to 1)
+	// instead of raw counts.
+	Normalize bool
+}
+
+// NewHistogram buckets samples into bins and returns a ready-to-plot Bar
+// Dataset, with Xs() at bin centers and Ys() at the bin counts (or
+// densities, if opts.Normalize). It also configures chart with a Linear
+// X-axis labeled at the bin edges, so callers don't have to do that wiring
+// themselves.
+func NewHistogram(chart *chartjs.Chart, samples []float64, opts HistOpts) chartjs.Dataset {
+	min, max := opts.Min, opts.Max
+	if min == 0 && max == 0 {
+		min, max = sampleRange(samples)
+	}
+
+	bins := opts.Bins
+	if bins <= 0 {
+		bins = binCount(samples, opts.Rule, min, max)
+	}
+	if bins <= 0 {
+		bins = 1
+	}
+
+	width := (max - min) / float64(bins)
+	counts := make([]float64, bins)
+	for _, s := range samples {
+		i := 0
+		// min == max (all-identical samples, or an explicit zero-width
+		// Min/Max) makes width 0, which would otherwise divide by zero
+		// and convert the resulting NaN to an int with unspecified
+		// results. Every sample belongs in the single bin in that case.
+		if width > 0 {
+			i = int((s - min) / width)
+		}
+		if i < 0 {
+			i = 0
+		}
+		if i >= bins {
+			i = bins - 1
+		}
+		counts[i]++
+	}
+
+	centers := make([]float64, bins)
+	for i := range centers {
+		centers[i] = min + width*(float64(i)+0.5)
+	}
+
+	if opts.Normalize && len(samples) > 0 && width > 0 {
+		n := float64(len(samples))
+		for i := range counts {
+			counts[i] = counts[i] / (n * width)
+		}
+	}
+
+	edges := make([]string, bins+1)
+	for i := 0; i <= bins; i++ {
+		edges[i] = fmt.Sprintf("%.2f", min+width*float64(i))
+	}
+	chart.Data.Labels = edges
+	chart.AddXAxis(chartjs.Axis{Type: chartjs.Linear})
+
+	return chartjs.Dataset{
+		Data: histValues{xs: centers, ys: counts},
+		Type: chartjs.Bar,
+	}
+}
+
+// histValues implements chartjs.Values over precomputed bin centers/counts.
+type histValues struct {
+	xs, ys []float64
+}
+
+func (h histValues) Xs() []float64 { return h.xs }
+func (h histValues) Ys() []float64 { return h.ys }
+func (h histValues) Rs() []float64 { return nil }
+
+func sampleRange(samples []float64) (min, max float64) {
+	if len(samples) == 0 {
+		return 0, 0
+	}
+	min, max = samples[0], samples[0]
+	for _, s := range samples[1:] {
+		if s < min {
+			min = s
+		}
+		if s > max {
+			max = s
+		}
+	}
+	return min, max
+}
+
+func binCount(samples []float64, rule BinRule, min, max float64) int {
+	n := len(samples)
+	if n == 0 {
+		return 1
+	}
+	switch rule {
+	case Rice:
+		return int(math.Ceil(2 * math.Cbrt(float64(n))))
+	case Sqrt:
+		return int(math.Ceil(math.Sqrt(float64(n))))
+	case FreedmanDiaconis:
+		iqr := interquartileRange(samples)
+		if iqr == 0 {
+			return int(math.Ceil(math.Sqrt(float64(n))))
+		}
+		width := 2 * iqr / math.Cbrt(float64(n))
+		if width <= 0 {
+			return int(math.Ceil(math.Sqrt(float64(n))))
+		}
+		return int(math.Ceil((max - min) / width))
+	default: // Sturges
+		return int(math.Ceil(math.Log2(float64(n)) + 1))
+	}
+}
+
+func interquartileRange(samples []float64) float64 {
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+	q1 := percentile(sorted, 0.25)
+	q3 := percentile(sorted, 0.75)
+	return q3 - q1
+}
+
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := p * float64(len(sorted)-1)
+	lo := int(math.Floor(idx))
+	hi := int(math.Ceil(idx))
+	if lo == hi {
+		return sorted[lo]
+	}
+	frac := idx - float64(lo)
+	return sorted[lo]*(1-frac) + sorted[hi]*frac
+}
@@ -0,0 +1,70 @@
+package hist
+
+import (
+	"testing"
+
+	chartjs "github.com/viseth/go-chartjs"
+)
+
+func TestNewHistogramIdenticalSamples(t *testing.T) {
+	chart := &chartjs.Chart{}
+	d := NewHistogram(chart, []float64{5, 5, 5, 5}, HistOpts{Bins: 4})
+
+	ys := d.Data.Ys()
+	var total float64
+	for _, y := range ys {
+		total += y
+	}
+	if total != 4 {
+		t.Fatalf("got %v total counts across bins %v, want all 4 samples accounted for", total, ys)
+	}
+}
+
+func TestNewHistogramExplicitZeroWidthRange(t *testing.T) {
+	chart := &chartjs.Chart{}
+	d := NewHistogram(chart, []float64{1, 2, 3}, HistOpts{Bins: 3, Min: 5, Max: 5})
+
+	ys := d.Data.Ys()
+	var total float64
+	for _, y := range ys {
+		total += y
+	}
+	if total != 3 {
+		t.Fatalf("got %v total counts across bins %v, want all 3 samples accounted for", total, ys)
+	}
+}
+
+func TestNewHistogramBinCounts(t *testing.T) {
+	chart := &chartjs.Chart{}
+	d := NewHistogram(chart, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, HistOpts{Bins: 2, Min: 0, Max: 10})
+
+	xs, ys := d.Data.Xs(), d.Data.Ys()
+	if len(xs) != 2 || len(ys) != 2 {
+		t.Fatalf("got %d bins, want 2", len(xs))
+	}
+	if ys[0] != 5 || ys[1] != 5 {
+		t.Errorf("got bin counts %v, want [5 5]", ys)
+	}
+}
+
+func TestNewHistogramConfiguresChart(t *testing.T) {
+	chart := &chartjs.Chart{}
+	NewHistogram(chart, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, HistOpts{Bins: 2, Min: 0, Max: 10})
+
+	wantEdges := []string{"0.00", "5.00", "10.00"}
+	if len(chart.Data.Labels) != len(wantEdges) {
+		t.Fatalf("got labels %v, want %v", chart.Data.Labels, wantEdges)
+	}
+	for i, e := range wantEdges {
+		if chart.Data.Labels[i] != e {
+			t.Errorf("label %d = %q, want %q", i, chart.Data.Labels[i], e)
+		}
+	}
+
+	if len(chart.Options.Scales.XAxes) != 1 {
+		t.Fatalf("got %d X-axes, want 1", len(chart.Options.Scales.XAxes))
+	}
+	if chart.Options.Scales.XAxes[0].Type != chartjs.Linear {
+		t.Errorf("X-axis type = %v, want chartjs.Linear", chart.Options.Scales.XAxes[0].Type)
+	}
+}
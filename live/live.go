@@ -0,0 +1,179 @@
+// Package live adds real-time chart.js dashboards on top of chartjs.Chart,
+// pushing incremental updates to the browser over a WebSocket instead of
+// requiring callers to re-render the whole page.
+//
+// Note: Go does not allow attaching methods to a type from another package,
+// so what would read as `Chart.ServeLive` lives here as the package-level
+// function ServeLive(chart, dataset, w, r) instead.
+package live
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	chartjs "github.com/viseth/go-chartjs"
+)
+
+// UpdateMode determines how an incoming Values message is applied to the
+// chart in the browser.
+type UpdateMode int
+
+const (
+	// Append adds the new points to the end of the existing series.
+	Append UpdateMode = iota
+	// Replace discards the existing series and substitutes the new points.
+	Replace
+	// Window behaves like Append but then trims the oldest points so the
+	// series never exceeds LiveDataset.MaxPoints.
+	Window
+)
+
+// subscriberBufSize bounds how far a single slow WebSocket connection can
+// lag behind Push before its updates start being dropped, so one slow
+// client can't stall delivery to the others.
+const subscriberBufSize = 16
+
+// LiveDataset wraps a chartjs.Dataset with a channel of incremental updates.
+// Every Values sent to Push is fanned out to every connection ServeLive has
+// open against this dataset, not just the first one.
+type LiveDataset struct {
+	chartjs.Dataset
+
+	// Mode controls how each received Values is merged into the series
+	// that chart.js holds client-side.
+	Mode UpdateMode
+	// MaxPoints bounds the series length when Mode is Window. Ignored
+	// otherwise.
+	MaxPoints int
+
+	updates chan chartjs.Values
+
+	mu          sync.Mutex
+	subscribers map[chan chartjs.Values]struct{}
+	closed      bool
+}
+
+// NewLiveDataset wraps d for streaming, buffering up to bufSize pending
+// updates before Push blocks.
+func NewLiveDataset(d chartjs.Dataset, bufSize int) *LiveDataset {
+	l := &LiveDataset{
+		Dataset:     d,
+		updates:     make(chan chartjs.Values, bufSize),
+		subscribers: make(map[chan chartjs.Values]struct{}),
+	}
+	go l.fanOut()
+	return l
+}
+
+// fanOut reads every Values pushed to l.updates and relays it to each
+// currently-subscribed connection, so Push has exactly one reader (this
+// goroutine) and any number of ServeLive calls can subscribe downstream of
+// it.
+func (l *LiveDataset) fanOut() {
+	for v := range l.updates {
+		l.mu.Lock()
+		for sub := range l.subscribers {
+			select {
+			case sub <- v:
+			default:
+				// Slow subscriber: drop this update for it rather than
+				// blocking delivery to the rest.
+			}
+		}
+		l.mu.Unlock()
+	}
+
+	l.mu.Lock()
+	for sub := range l.subscribers {
+		close(sub)
+	}
+	l.subscribers = nil
+	l.closed = true
+	l.mu.Unlock()
+}
+
+// subscribe registers a new per-connection channel that receives every
+// subsequent Push. Callers must unsubscribe when done.
+func (l *LiveDataset) subscribe() chan chartjs.Values {
+	sub := make(chan chartjs.Values, subscriberBufSize)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		close(sub)
+		return sub
+	}
+	l.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (l *LiveDataset) unsubscribe(sub chan chartjs.Values) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.subscribers, sub)
+}
+
+// Push enqueues v to be sent to every connected client.
+func (l *LiveDataset) Push(v chartjs.Values) {
+	l.updates <- v
+}
+
+// Close stops accepting further updates, closing out every ServeLive
+// connection currently serving this dataset.
+func (l *LiveDataset) Close() {
+	close(l.updates)
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+type wireMessage struct {
+	Mode      UpdateMode      `json:"mode"`
+	MaxPoints int             `json:"maxPoints,omitempty"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// ServeLive upgrades the connection to a WebSocket and streams chart to the
+// browser: first the initial Chart JSON, then one wireMessage per Values
+// pushed to ds until ds is closed or the client disconnects. Multiple
+// concurrent ServeLive calls against the same ds each get their own
+// subscription and all receive every update pushed after they subscribe,
+// except updates dropped for a connection whose subscriberBufSize buffer is
+// full.
+func ServeLive(chart *chartjs.Chart, ds *LiveDataset, w http.ResponseWriter, r *http.Request) error {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	initial, err := json.Marshal(chart)
+	if err != nil {
+		return err
+	}
+	if err := conn.WriteMessage(websocket.TextMessage, initial); err != nil {
+		return err
+	}
+
+	sub := ds.subscribe()
+	defer ds.unsubscribe(sub)
+
+	for v := range sub {
+		points, err := chartjs.MarshalValues(v)
+		if err != nil {
+			return err
+		}
+		msg := wireMessage{Mode: ds.Mode, MaxPoints: ds.MaxPoints, Data: points}
+		b, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		if err := conn.WriteMessage(websocket.TextMessage, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
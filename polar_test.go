@@ -0,0 +1,60 @@
+package chartjs
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestChartSetPolarAxisSerializesSingularScale(t *testing.T) {
+	c := &Chart{Type: Radar}
+	c.SetPolarAxis(Axis{Type: Radial, Label: "votes"})
+
+	buf, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(buf, &out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	options, ok := out["options"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("got options = %#v, want an object", out["options"])
+	}
+	if _, ok := options["scales"]; ok {
+		t.Errorf("got options.scales = %v, want it omitted when a polar Scale is set", options["scales"])
+	}
+	scale, ok := options["scale"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("got options.scale = %#v, want an object", options["scale"])
+	}
+	if scale["label"] != "votes" {
+		t.Errorf("options.scale.label = %v, want \"votes\"", scale["label"])
+	}
+}
+
+func TestChartWithoutPolarAxisSerializesScales(t *testing.T) {
+	c := &Chart{Type: Line}
+	c.AddXAxis(Axis{Type: Linear})
+
+	buf, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(buf, &out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	options, ok := out["options"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("got options = %#v, want an object", out["options"])
+	}
+	if _, ok := options["scale"]; ok {
+		t.Errorf("got options.scale = %v, want it absent without SetPolarAxis", options["scale"])
+	}
+	if _, ok := options["scales"]; !ok {
+		t.Errorf("got no options.scales, want it present")
+	}
+}
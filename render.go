@@ -0,0 +1,114 @@
+package chartjs
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chromedp/chromedp"
+)
+
+// ChartJSSrc is the <script src="..."> used by RenderHTML to load Chart.js.
+// It defaults to a CDN build; set it to a local path (e.g. one served
+// alongside the HTML) to avoid the network dependency.
+var ChartJSSrc = "https://cdn.jsdelivr.net/npm/chart.js"
+
+// AnnotationPluginSrc is the <script src="..."> RenderHTML adds when the
+// chart carries annotation config (Chart.AddMarkLine/AddMarkPoint/AddBox),
+// so chartjs-plugin-annotation is present to render it.
+var AnnotationPluginSrc = "https://cdn.jsdelivr.net/npm/chartjs-plugin-annotation"
+
+//go:embed render.html.tmpl
+var renderHTMLTmpl string
+
+var htmlTemplate = template.Must(template.New("chart").Parse(renderHTMLTmpl))
+
+type htmlTemplateData struct {
+	ChartJSSrc          string
+	AnnotationPluginSrc string
+	ChartJSON           template.JS
+	Width, Height       int
+}
+
+// RenderHTML writes a standalone HTML page embedding the chart: a <canvas>
+// plus the script that loads Chart.js (from ChartJSSrc) and constructs the
+// chart from this Chart's JSON. The canvas sizes itself responsively to its
+// container; use RenderPNG/SaveBinary for a canvas pinned to exact pixel
+// dimensions.
+func (c *Chart) RenderHTML(w io.Writer) error {
+	return c.renderHTML(w, 0, 0)
+}
+
+// renderHTML is RenderHTML with an optional fixed pixel size for the
+// canvas's container; width/height of 0 leaves it responsive.
+func (c *Chart) renderHTML(w io.Writer, width, height int) error {
+	buf, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	data := htmlTemplateData{
+		ChartJSSrc: ChartJSSrc,
+		ChartJSON:  template.JS(buf),
+		Width:      width,
+		Height:     height,
+	}
+	if c.hasAnnotations() {
+		data.AnnotationPluginSrc = AnnotationPluginSrc
+	}
+	return htmlTemplate.Execute(w, data)
+}
+
+// SaveHTML renders the chart to a standalone HTML file at path, mirroring
+// RenderHTML.
+func (c *Chart) SaveHTML(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.RenderHTML(f)
+}
+
+// RenderPNG rasterizes the chart at width x height by rendering the chart
+// into a canvas container pinned to exactly those pixel dimensions, loading
+// that HTML in a headless browser (via chromedp), and screenshotting the
+// canvas.
+func (c *Chart) RenderPNG(w io.Writer, width, height int) error {
+	var html strings.Builder
+	if err := c.renderHTML(&html, width, height); err != nil {
+		return err
+	}
+
+	ctx, cancel := chromedp.NewContext(context.Background())
+	defer cancel()
+	ctx, cancel = context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var png []byte
+	err := chromedp.Run(ctx,
+		chromedp.EmulateViewport(int64(width), int64(height)),
+		chromedp.Navigate("data:text/html,"+html.String()),
+		chromedp.WaitVisible("canvas", chromedp.ByQuery),
+		chromedp.Screenshot("canvas", &png, chromedp.ByQuery),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(png)
+	return err
+}
+
+// SaveBinary renders the chart to a PNG file at path, mirroring RenderPNG.
+func (c *Chart) SaveBinary(path string, width, height int) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.RenderPNG(f, width, height)
+}
@@ -0,0 +1,75 @@
+package chartjs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// PointStyle overrides dataset-wide styling for a single point. A zero
+// PointStyle applies no override.
+type PointStyle struct {
+	BackgroundColor *RGBA
+	BorderColor     *RGBA
+	PointRadius     float64
+	Label           string
+}
+
+// RichValues optionally augments Values with per-point styling and/or a
+// time-series X-axis. A Dataset whose Data implements RichValues is
+// detected via interface assertion in marshalValuesJSON, so existing Values
+// implementations remain unaffected.
+type RichValues interface {
+	Values
+
+	// Times, if non-empty, is used in place of Xs() and causes each point
+	// to be serialized with an ISO-8601 "x" string. Chart.AddDataset sets
+	// the chart's X-axis to Time automatically when this is the case.
+	Times() []time.Time
+	// PointStyle returns the style override for the point at index i.
+	PointStyle(i int) PointStyle
+}
+
+type richPoint struct {
+	X               interface{} `json:"x"`
+	Y               float64     `json:"y"`
+	R               float64     `json:"r,omitempty"`
+	BackgroundColor *RGBA       `json:"backgroundColor,omitempty"`
+	BorderColor     *RGBA       `json:"borderColor,omitempty"`
+	PointRadius     float64     `json:"pointRadius,omitempty"`
+	Label           string      `json:"label,omitempty"`
+}
+
+func marshalRichValuesJSON(rv RichValues) ([]byte, error) {
+	xs, ys, rs := rv.Xs(), rv.Ys(), rv.Rs()
+	times := rv.Times()
+
+	n := len(xs)
+	if len(times) > 0 {
+		n = len(times)
+	}
+
+	points := make([]richPoint, n)
+	for i := 0; i < n; i++ {
+		p := richPoint{}
+		switch {
+		case len(times) > 0:
+			p.X = times[i].Format(time.RFC3339)
+		case i < len(xs):
+			p.X = xs[i]
+		}
+		if i < len(ys) {
+			p.Y = ys[i]
+		}
+		if i < len(rs) {
+			p.R = rs[i]
+		}
+
+		style := rv.PointStyle(i)
+		p.BackgroundColor = style.BackgroundColor
+		p.BorderColor = style.BorderColor
+		p.PointRadius = style.PointRadius
+		p.Label = style.Label
+		points[i] = p
+	}
+	return json.Marshal(points)
+}
@@ -0,0 +1,45 @@
+package chartjs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type richValuesStub struct {
+	xs, ys, rs []float64
+	times      []time.Time
+}
+
+func (r richValuesStub) Xs() []float64      { return r.xs }
+func (r richValuesStub) Ys() []float64      { return r.ys }
+func (r richValuesStub) Rs() []float64      { return r.rs }
+func (r richValuesStub) Times() []time.Time { return r.times }
+func (r richValuesStub) PointStyle(i int) PointStyle {
+	return PointStyle{}
+}
+
+func TestMarshalRichValuesJSONKeepsZeroY(t *testing.T) {
+	rv := richValuesStub{xs: []float64{1, 2, 3}, ys: []float64{0, 5, 0}}
+
+	buf, err := marshalRichValuesJSON(rv)
+	if err != nil {
+		t.Fatalf("marshalRichValuesJSON: %v", err)
+	}
+
+	var points []map[string]json.Number
+	if err := json.Unmarshal(buf, &points); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(points) != 3 {
+		t.Fatalf("got %d points, want 3", len(points))
+	}
+	for i, p := range points {
+		if _, ok := p["y"]; !ok {
+			t.Errorf("point %d: missing y key, got %v", i, p)
+		}
+	}
+	if points[0]["y"].String() != "0" || points[2]["y"].String() != "0" {
+		t.Errorf("points[0]/points[2] y = %v/%v, want 0/0", points[0]["y"], points[2]["y"])
+	}
+}
@@ -0,0 +1,124 @@
+package chartjs
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// HierValues dictates the interface of hierarchical data to be plotted,
+// e.g. for Sunburst charts.
+type HierValues interface {
+	// Label names this node for tooltips/legends.
+	Label() string
+	// Value is this node's weight. Leaf nodes should always set one;
+	// branch nodes may leave it zero and let it be derived from Children.
+	Value() float64
+	// Color is this node's wedge color, or nil to let chart.js pick one.
+	Color() *RGBA
+	// Children returns the nested nodes, or nil for a leaf.
+	Children() []HierValues
+}
+
+func marshalHierValuesJSON(nodes []HierValues) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	buf.WriteRune('[')
+	for i, n := range nodes {
+		if i > 0 {
+			buf.WriteRune(',')
+		}
+		b, err := marshalHierValueJSON(n)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	buf.WriteRune(']')
+	return buf.Bytes(), nil
+}
+
+func marshalHierValueJSON(n HierValues) ([]byte, error) {
+	type node struct {
+		Label           string  `json:"label,omitempty"`
+		Value           float64 `json:"value,omitempty"`
+		BackgroundColor *RGBA   `json:"backgroundColor,omitempty"`
+		Children        []byte  `json:"-"`
+	}
+	children := n.Children()
+	out := node{Label: n.Label(), Value: n.Value(), BackgroundColor: n.Color()}
+	buf, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if len(children) == 0 {
+		return buf, nil
+	}
+	childrenJSON, err := marshalHierValuesJSON(children)
+	if err != nil {
+		return nil, err
+	}
+	buf[len(buf)-1] = ','
+	buf = append(buf, []byte(`"children":`)...)
+	buf = append(buf, childrenJSON...)
+	buf = append(buf, '}')
+	return buf, nil
+}
+
+// TreeDataset wraps a hierarchical "dataset" for Sunburst/Tree charts. Unlike
+// Dataset, its Data is a tree of HierValues rather than a flat series.
+type TreeDataset struct {
+	Data            []HierValues `json:"-"`
+	Type            chartType    `json:"type,omitempty"`
+	BackgroundColor *RGBA        `json:"backgroundColor,omitempty"`
+	BorderColor     *RGBA        `json:"borderColor,omitempty"`
+
+	// Label indicates the name of the dataset to be shown in the legend.
+	Label string `json:"label,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (d TreeDataset) MarshalJSON() ([]byte, error) {
+	o, err := marshalHierValuesJSON(d.Data)
+	if err != nil {
+		return nil, err
+	}
+	// avoid recursion by creating an alias.
+	type alias TreeDataset
+	buf, err := json.Marshal(alias(d))
+	if err != nil {
+		return nil, err
+	}
+	// All of alias's fields are "omitempty", so a TreeDataset with none of
+	// them set (the common case: chart-level Type is already Sunburst,
+	// so per-dataset Type/Label/colors are usually left zero) marshals to
+	// the empty object "{}". Swapping its trailing '}' for ',' would then
+	// produce invalid JSON ("{,\"data\":...}"), so handle that case
+	// directly instead.
+	if len(buf) == 2 {
+		buf = []byte(`{"data":`)
+		buf = append(buf, o...)
+		return append(buf, '}'), nil
+	}
+	// replace '}' with ',' to continue struct
+	buf[len(buf)-1] = ','
+	buf = append(buf, []byte(`"data":`)...)
+	buf = append(buf, o...)
+	buf = append(buf, '}')
+	return buf, nil
+}
+
+// SunburstOptions wraps the options consumed by the chartjs-chart-sunburst
+// plugin. It is emitted under Options.Sunburst.
+type SunburstOptions struct {
+	// NodeClick controls what happens when a node is clicked: "zoom" or "".
+	NodeClick string `json:"nodeClick,omitempty"`
+	// Sort orders sibling nodes: "desc", "asc", or "" to leave as given.
+	Sort string `json:"sort,omitempty"`
+	// RenderLabelForZeroData renders a node's label even when its value is 0.
+	RenderLabelForZeroData Bool `json:"renderLabelForZeroData,omitempty"`
+
+	Animation               Bool    `json:"animation,omitempty"`
+	AnimationDuration       float64 `json:"animationDuration,omitempty"`
+	AnimationEasing         string  `json:"animationEasing,omitempty"`
+	AnimationDelay          float64 `json:"animationDelay,omitempty"`
+	AnimationDurationUpdate float64 `json:"animationDurationUpdate,omitempty"`
+}
@@ -0,0 +1,96 @@
+package chartjs
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type hierValueStub struct {
+	label    string
+	value    float64
+	color    *RGBA
+	children []HierValues
+}
+
+func (h hierValueStub) Label() string          { return h.label }
+func (h hierValueStub) Value() float64         { return h.value }
+func (h hierValueStub) Color() *RGBA           { return h.color }
+func (h hierValueStub) Children() []HierValues { return h.children }
+
+func TestTreeDatasetMarshalJSONValid(t *testing.T) {
+	d := TreeDataset{
+		Data: []HierValues{
+			hierValueStub{label: "a", value: 1},
+			hierValueStub{label: "b", value: 2},
+		},
+	}
+
+	buf, err := d.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(buf, &out); err != nil {
+		t.Fatalf("MarshalJSON produced invalid JSON %q: %v", buf, err)
+	}
+
+	data, ok := out["data"].([]interface{})
+	if !ok || len(data) != 2 {
+		t.Fatalf("got data = %#v, want a 2-element array", out["data"])
+	}
+}
+
+func TestTreeDatasetMarshalJSONPerNodeColor(t *testing.T) {
+	red := RGBA{R: 255, A: 255}
+	d := TreeDataset{
+		Data: []HierValues{
+			hierValueStub{label: "a", value: 1, color: &red},
+			hierValueStub{label: "b", value: 2},
+		},
+	}
+
+	buf, err := d.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var out struct {
+		Data []struct {
+			Label           string `json:"label"`
+			BackgroundColor string `json:"backgroundColor"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &out); err != nil {
+		t.Fatalf("MarshalJSON produced invalid JSON %q: %v", buf, err)
+	}
+	if len(out.Data) != 2 {
+		t.Fatalf("got %d nodes, want 2", len(out.Data))
+	}
+	if out.Data[0].BackgroundColor == "" {
+		t.Errorf("node %q: missing backgroundColor", out.Data[0].Label)
+	}
+	if out.Data[1].BackgroundColor != "" {
+		t.Errorf("node %q: got backgroundColor %q, want none", out.Data[1].Label, out.Data[1].BackgroundColor)
+	}
+}
+
+func TestTreeDatasetMarshalJSONWithFieldsSet(t *testing.T) {
+	d := TreeDataset{
+		Data:  []HierValues{hierValueStub{label: "a", value: 1}},
+		Label: "revenue",
+	}
+
+	buf, err := d.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(buf, &out); err != nil {
+		t.Fatalf("MarshalJSON produced invalid JSON %q: %v", buf, err)
+	}
+	if out["label"] != "revenue" {
+		t.Errorf("label = %v, want \"revenue\"", out["label"])
+	}
+}